@@ -0,0 +1,185 @@
+// Copyright 2025 Focela Authors.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0.
+// See the LICENSE file in the project root for full license information.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Duration wraps time.Duration so config files can express it as a string
+// like "30s" or "10m" rather than a number of nanoseconds.
+type Duration time.Duration
+
+// Duration returns the wrapped time.Duration.
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+func (d *Duration) UnmarshalYAML(value *yaml.Node) error {
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// Overlap policies controlling what happens when a schedule ticks again
+// before its previous run has finished.
+const (
+	OverlapAllow = "allow"
+	OverlapSkip  = "skip"
+	OverlapQueue = "queue"
+)
+
+// Job describes a single scheduled command, as read from a config file or
+// synthesized from the legacy positional-argument invocation.
+type Job struct {
+	Schedule  string            `yaml:"schedule" json:"schedule"`
+	Command   string            `yaml:"command" json:"command"`
+	Args      []string          `yaml:"args,omitempty" json:"args,omitempty"`
+	Dir       string            `yaml:"dir,omitempty" json:"dir,omitempty"`
+	Env       map[string]string `yaml:"env,omitempty" json:"env,omitempty"`
+	Timezone  string            `yaml:"timezone,omitempty" json:"timezone,omitempty"`
+	OnOverlap string            `yaml:"on_overlap,omitempty" json:"on_overlap,omitempty"`
+
+	// Retries is the number of additional attempts made after a failed run,
+	// before giving up on that tick.
+	Retries int `yaml:"retries,omitempty" json:"retries,omitempty"`
+	// RetryBackoff is the delay before the first retry; it doubles on each
+	// subsequent attempt, capped at RetryMaxBackoff.
+	RetryBackoff Duration `yaml:"retry_backoff,omitempty" json:"retry_backoff,omitempty"`
+	// RetryMaxBackoff caps the exponential backoff between retries.
+	RetryMaxBackoff Duration `yaml:"retry_max_backoff,omitempty" json:"retry_max_backoff,omitempty"`
+	// PauseAfterFailures opens the circuit breaker once this many
+	// consecutive ticks have failed (after retries are exhausted). Zero
+	// disables the circuit breaker.
+	PauseAfterFailures int `yaml:"pause_after_failures,omitempty" json:"pause_after_failures,omitempty"`
+	// PauseCooldown is how long the circuit stays open before the job is
+	// allowed to run again.
+	PauseCooldown Duration `yaml:"pause_cooldown,omitempty" json:"pause_cooldown,omitempty"`
+
+	// Timeout bounds a single run. Zero disables the timeout (the run is
+	// still subject to the process's shutdown context).
+	Timeout Duration `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	// KillGrace is how long to wait after SIGTERM before escalating to
+	// SIGKILL once a run is cancelled (by Timeout or shutdown).
+	KillGrace Duration `yaml:"kill_grace,omitempty" json:"kill_grace,omitempty"`
+}
+
+// Config is the top-level shape of a `-config` file: a list of jobs, each
+// scheduled independently and potentially in its own timezone.
+type Config struct {
+	Jobs []Job `yaml:"jobs" json:"jobs"`
+}
+
+// loadConfig reads and parses a job config file. The format (YAML or JSON)
+// is inferred from the file extension.
+func loadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config %q: %w", path, err)
+	}
+
+	var cfg Config
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		err = json.Unmarshal(data, &cfg)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &cfg)
+	default:
+		return nil, fmt.Errorf("unsupported config extension %q (want .yaml, .yml or .json)", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse config %q: %w", path, err)
+	}
+
+	if len(cfg.Jobs) == 0 {
+		return nil, fmt.Errorf("config %q defines no jobs", path)
+	}
+	for i, job := range cfg.Jobs {
+		if job.Schedule == "" {
+			return nil, fmt.Errorf("config %q: job %d is missing a schedule", path, i)
+		}
+		if job.Command == "" {
+			return nil, fmt.Errorf("config %q: job %d is missing a command", path, i)
+		}
+		if job.OnOverlap != "" {
+			if err := validateOverlapPolicy(job.OnOverlap); err != nil {
+				return nil, fmt.Errorf("config %q: job %d: %w", path, i, err)
+			}
+		}
+		if err := validateRetries(job.Retries); err != nil {
+			return nil, fmt.Errorf("config %q: job %d: %w", path, i, err)
+		}
+		if job.PauseAfterFailures < 0 {
+			return nil, fmt.Errorf("config %q: job %d: pause_after_failures must be >= 0, got %d", path, i, job.PauseAfterFailures)
+		}
+	}
+
+	return &cfg, nil
+}
+
+// validateOverlapPolicy rejects anything other than the known overlap
+// policies.
+func validateOverlapPolicy(policy string) error {
+	switch policy {
+	case OverlapAllow, OverlapSkip, OverlapQueue:
+		return nil
+	default:
+		return fmt.Errorf("invalid on-overlap policy %q (want %q, %q or %q)", policy, OverlapAllow, OverlapSkip, OverlapQueue)
+	}
+}
+
+// validateRetries rejects a negative retry count, which would otherwise
+// make the retry loop never call execute and the tick silently "succeed"
+// without ever running the command.
+func validateRetries(retries int) error {
+	if retries < 0 {
+		return fmt.Errorf("retries must be >= 0, got %d", retries)
+	}
+	return nil
+}
+
+// envSlice flattens a job's environment map into `KEY=VALUE` pairs suitable
+// for appending to os.Environ().
+func envSlice(env map[string]string) []string {
+	if len(env) == 0 {
+		return nil
+	}
+
+	out := make([]string, 0, len(env))
+	for k, v := range env {
+		out = append(out, k+"="+v)
+	}
+	return out
+}
@@ -0,0 +1,184 @@
+// Copyright 2025 Focela Authors.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0.
+// See the LICENSE file in the project root for full license information.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestDurationUnmarshalYAML(t *testing.T) {
+	var d Duration
+	if err := yaml.Unmarshal([]byte(`"30s"`), &d); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if d.Duration() != 30*time.Second {
+		t.Fatalf("Duration() = %v, want 30s", d.Duration())
+	}
+}
+
+func TestDurationUnmarshalYAMLInvalid(t *testing.T) {
+	var d Duration
+	if err := yaml.Unmarshal([]byte(`"not-a-duration"`), &d); err == nil {
+		t.Fatal("expected an error for an invalid duration string, got nil")
+	}
+}
+
+func TestDurationUnmarshalJSON(t *testing.T) {
+	var d Duration
+	if err := json.Unmarshal([]byte(`"10m"`), &d); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if d.Duration() != 10*time.Minute {
+		t.Fatalf("Duration() = %v, want 10m", d.Duration())
+	}
+}
+
+func TestDurationUnmarshalJSONInvalid(t *testing.T) {
+	var d Duration
+	if err := json.Unmarshal([]byte(`"not-a-duration"`), &d); err == nil {
+		t.Fatal("expected an error for an invalid duration string, got nil")
+	}
+}
+
+func TestValidateOverlapPolicy(t *testing.T) {
+	for _, policy := range []string{OverlapAllow, OverlapSkip, OverlapQueue} {
+		if err := validateOverlapPolicy(policy); err != nil {
+			t.Errorf("validateOverlapPolicy(%q) = %v, want nil", policy, err)
+		}
+	}
+	if err := validateOverlapPolicy("bogus"); err == nil {
+		t.Error("validateOverlapPolicy(\"bogus\") = nil, want an error")
+	}
+}
+
+func TestValidateRetries(t *testing.T) {
+	if err := validateRetries(0); err != nil {
+		t.Errorf("validateRetries(0) = %v, want nil", err)
+	}
+	if err := validateRetries(3); err != nil {
+		t.Errorf("validateRetries(3) = %v, want nil", err)
+	}
+	if err := validateRetries(-1); err == nil {
+		t.Error("validateRetries(-1) = nil, want an error")
+	}
+}
+
+func writeConfig(t *testing.T, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestLoadConfigYAML(t *testing.T) {
+	path := writeConfig(t, "jobs.yaml", `
+jobs:
+  - schedule: "@every 1m"
+    command: echo
+    args: ["hi"]
+    retries: 2
+`)
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+	if len(cfg.Jobs) != 1 || cfg.Jobs[0].Command != "echo" || cfg.Jobs[0].Retries != 2 {
+		t.Fatalf("loadConfig() = %+v, want one echo job with retries=2", cfg.Jobs)
+	}
+}
+
+func TestLoadConfigUnsupportedExtension(t *testing.T) {
+	path := writeConfig(t, "jobs.txt", `jobs: []`)
+	if _, err := loadConfig(path); err == nil {
+		t.Fatal("expected an error for an unsupported config extension, got nil")
+	}
+}
+
+func TestLoadConfigEmptyJobs(t *testing.T) {
+	path := writeConfig(t, "jobs.yaml", `jobs: []`)
+	if _, err := loadConfig(path); err == nil {
+		t.Fatal("expected an error for a config with no jobs, got nil")
+	}
+}
+
+func TestLoadConfigMissingSchedule(t *testing.T) {
+	path := writeConfig(t, "jobs.yaml", `
+jobs:
+  - command: echo
+`)
+	if _, err := loadConfig(path); err == nil {
+		t.Fatal("expected an error for a job missing a schedule, got nil")
+	}
+}
+
+func TestLoadConfigMissingCommand(t *testing.T) {
+	path := writeConfig(t, "jobs.yaml", `
+jobs:
+  - schedule: "@every 1m"
+`)
+	if _, err := loadConfig(path); err == nil {
+		t.Fatal("expected an error for a job missing a command, got nil")
+	}
+}
+
+func TestLoadConfigInvalidOverlapPolicy(t *testing.T) {
+	path := writeConfig(t, "jobs.yaml", `
+jobs:
+  - schedule: "@every 1m"
+    command: echo
+    on_overlap: bogus
+`)
+	if _, err := loadConfig(path); err == nil {
+		t.Fatal("expected an error for an invalid on_overlap policy, got nil")
+	}
+}
+
+func TestLoadConfigNegativeRetries(t *testing.T) {
+	path := writeConfig(t, "jobs.yaml", `
+jobs:
+  - schedule: "@every 1m"
+    command: echo
+    retries: -1
+`)
+	if _, err := loadConfig(path); err == nil {
+		t.Fatal("expected an error for negative retries, got nil")
+	}
+}
+
+func TestLoadConfigNegativePauseAfterFailures(t *testing.T) {
+	path := writeConfig(t, "jobs.yaml", `
+jobs:
+  - schedule: "@every 1m"
+    command: echo
+    pause_after_failures: -1
+`)
+	if _, err := loadConfig(path); err == nil {
+		t.Fatal("expected an error for negative pause_after_failures, got nil")
+	}
+}
+
+func TestLoadConfigJSON(t *testing.T) {
+	path := writeConfig(t, "jobs.json", `{"jobs": [{"schedule": "@every 1m", "command": "echo"}]}`)
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+	if len(cfg.Jobs) != 1 || cfg.Jobs[0].Command != "echo" {
+		t.Fatalf("loadConfig() = %+v, want one echo job", cfg.Jobs)
+	}
+}
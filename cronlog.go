@@ -0,0 +1,31 @@
+// Copyright 2025 Focela Authors.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0.
+// See the LICENSE file in the project root for full license information.
+
+package main
+
+import (
+	"github.com/robfig/cron/v3"
+)
+
+// slogCronLogger adapts our structured logger to the cron.Logger interface
+// expected by robfig/cron's job wrappers (e.g. SkipIfStillRunning), so
+// overlap-skip and overlap-delay events show up in the same log stream as
+// everything else.
+type slogCronLogger struct {
+	schedule string
+	command  string
+}
+
+func (l slogCronLogger) Info(msg string, keysAndValues ...interface{}) {
+	logger.Info("cron: "+msg, append([]interface{}{"schedule", l.schedule, "command", l.command}, keysAndValues...)...)
+}
+
+func (l slogCronLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	logger.Error("cron: "+msg, append([]interface{}{"schedule", l.schedule, "command", l.command, "error", err}, keysAndValues...)...)
+}
+
+var _ cron.Logger = slogCronLogger{}
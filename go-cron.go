@@ -10,15 +10,20 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
 	"os/exec"
 	"os/signal"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/robfig/cron/v3"
 )
 
@@ -34,65 +39,287 @@ var (
 )
 
 const (
-	minArgs         = 3
-	shutdownTimeout = 30 * time.Second
+	minPositionalArgs = 2 // schedule, command
+	shutdownTimeout   = 30 * time.Second
+	defaultKillGrace  = 10 * time.Second
 )
 
-// execute runs a command and blocks until completion.
-func execute(ctx context.Context, schedule string, command string, args []string) error {
-	logger.Info("executing command", "schedule", schedule, "command", command, "args", args)
+// ErrJobTimeout marks an error as coming from a per-run timeout, so callers
+// can distinguish it from an ordinary command failure.
+var ErrJobTimeout = errors.New("job exceeded its timeout")
 
-	cmd := exec.CommandContext(ctx, command, args...)
+// execute runs a command and blocks until completion, a timeout, or
+// cancellation of ctx (e.g. on shutdown). The command runs in its own
+// process group so that on timeout or cancellation its children are
+// terminated too, not just the command itself.
+func execute(ctx context.Context, job Job) error {
+	logger.Info("executing command", "schedule", job.Schedule, "command", job.Command, "args", job.Args)
+
+	runCtx := ctx
+	if timeout := job.Timeout.Duration(); timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	cmd := exec.Command(job.Command, job.Args...)
+	cmd.Dir = job.Dir
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
+	if len(job.Env) > 0 {
+		cmd.Env = append(os.Environ(), envSlice(job.Env)...)
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("command start failed: %w", err)
+	}
 
-	if err := cmd.Run(); err != nil {
-		if ctx.Err() == context.Canceled {
-			return fmt.Errorf("command cancelled: %w", err)
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("command execution failed: %w", err)
+		}
+		return nil
+	case <-runCtx.Done():
+		killGrace := job.KillGrace.Duration()
+		if killGrace <= 0 {
+			killGrace = defaultKillGrace
 		}
-		return fmt.Errorf("command execution failed: %w", err)
+		return terminateProcessGroup(cmd, done, killGrace, runCtx.Err())
 	}
-	return nil
 }
 
-// create initializes the cron scheduler and returns it with a WaitGroup.
-func create(ctx context.Context, schedule string, command string, args []string) (*cron.Cron, *sync.WaitGroup, error) {
-	wg := &sync.WaitGroup{}
+// terminateProcessGroup sends SIGTERM to the command's process group,
+// escalating to SIGKILL if it hasn't exited within killGrace. cause is the
+// context error (timeout or cancellation) that triggered the termination.
+func terminateProcessGroup(cmd *exec.Cmd, done chan error, killGrace time.Duration, cause error) error {
+	pgid := cmd.Process.Pid
+	logger.Warn("sending SIGTERM to command process group", "pid", pgid, "grace", killGrace, "cause", cause)
+	_ = syscall.Kill(-pgid, syscall.SIGTERM)
+
+	select {
+	case <-done:
+	case <-time.After(killGrace):
+		logger.Warn("kill grace period expired, sending SIGKILL", "pid", pgid)
+		_ = syscall.Kill(-pgid, syscall.SIGKILL)
+		<-done
+	}
+
+	if errors.Is(cause, context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %v", ErrJobTimeout, cause)
+	}
+	return fmt.Errorf("command cancelled: %w", cause)
+}
+
+// scheduledJob ties a registered Job to the scheduler and entry that will
+// run it, so its next fire time can be looked up after Start, plus its
+// run-history status and circuit breaker state exposed over HTTP.
+type scheduledJob struct {
+	cron    *cron.Cron
+	id      cron.EntryID
+	job     Job
+	status  *JobStatus
+	circuit *circuitBreaker
+}
+
+// runTick executes a job's command (retrying on failure per job.Retries)
+// for a single scheduled tick, records the outcome on its status and
+// metrics, and feeds the result into the job's circuit breaker.
+func runTick(ctx context.Context, job Job, status *JobStatus, circuit *circuitBreaker, metrics *Metrics) {
+	cooldown := job.PauseCooldown.Duration()
+	if cooldown <= 0 {
+		cooldown = defaultPauseCooldown
+	}
+
+	if job.PauseAfterFailures > 0 && circuit.open(time.Now()) {
+		logger.Warn("circuit open, skipping run", "schedule", job.Schedule, "command", job.Command)
+		return
+	}
+
+	start := time.Now()
+	status.recordStart(start)
+	metrics.incInFlight(job.Schedule, job.Command)
+	err := executeWithRetry(ctx, job)
+	metrics.decInFlight(job.Schedule, job.Command)
+	metrics.observeRun(job.Schedule, job.Command, time.Since(start), err)
+
+	exitCode := 0
+	if err != nil {
+		exitCode = -1
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			exitCode = exitErr.ExitCode()
+		}
+		if errors.Is(err, ErrJobTimeout) {
+			logger.Error("command timed out", "schedule", job.Schedule, "command", job.Command, "error", err)
+		} else {
+			logger.Error("command execution error", "schedule", job.Schedule, "command", job.Command, "error", err)
+		}
+
+		if job.PauseAfterFailures > 0 && circuit.recordFailure(job.PauseAfterFailures, cooldown) {
+			logger.Warn("circuit open", "schedule", job.Schedule, "command", job.Command,
+				"consecutive_failures", job.PauseAfterFailures, "cooldown", cooldown)
+		}
+	} else if job.PauseAfterFailures > 0 {
+		circuit.recordSuccess()
+	}
+
+	status.recordFinish(time.Now(), exitCode, err)
+}
+
+// loadJobLocation resolves a job's timezone, in priority order: the job's
+// own Timezone field (set via --tz or the config file), then the process's
+// TZ environment variable, then the local zone. A CRON_TZ= or TZ= prefix on
+// the schedule string itself (supported natively by robfig/cron v3's
+// parser) always takes precedence over all of these.
+//
+// Resolving a named zone requires the tzdata database to be present; minimal
+// base images (e.g. scratch or distroless without tzdata) must ship it
+// explicitly or this will fail.
+func loadJobLocation(job Job) (*time.Location, error) {
+	tz := job.Timezone
+	if tz == "" {
+		tz = os.Getenv("TZ")
+	}
+	if tz == "" {
+		return time.Local, nil
+	}
 
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("load timezone '%s' for job '%s': %w", tz, job.Command, err)
+	}
+	return loc, nil
+}
+
+// overlapPolicy returns the job's overlap policy, defaulting to skip so a
+// slow or stuck command doesn't pile up concurrent instances of itself.
+func overlapPolicy(job Job) string {
+	if job.OnOverlap == "" {
+		return OverlapSkip
+	}
+	return job.OnOverlap
+}
+
+// create builds one cron.Cron per distinct job timezone, registers every
+// job's function on the appropriate scheduler, and returns all of the
+// schedulers together with the shared WaitGroup used to drain running jobs
+// on shutdown.
+func create(ctx context.Context, jobs []Job, wg *sync.WaitGroup, metrics *Metrics) ([]*cron.Cron, []scheduledJob, error) {
 	parser := cron.NewParser(
 		cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
 	)
 
-	if _, err := parser.Parse(schedule); err != nil {
-		return nil, nil, fmt.Errorf("invalid schedule '%s': %w", schedule, err)
-	}
+	byLocation := map[string]*cron.Cron{}
+	var crons []*cron.Cron
+	var scheduled []scheduledJob
+
+	for _, job := range jobs {
+		if _, err := parser.Parse(job.Schedule); err != nil {
+			return nil, nil, fmt.Errorf("invalid schedule '%s': %w", job.Schedule, err)
+		}
 
-	c := cron.New(cron.WithParser(parser))
-	logger.Info("new cron scheduled", "schedule", schedule)
+		loc, err := loadJobLocation(job)
+		if err != nil {
+			return nil, nil, err
+		}
 
-	c.AddFunc(schedule, func() {
-		// Increment before context check to avoid shutdown race.
-		wg.Add(1)
-		defer wg.Done()
+		c, ok := byLocation[loc.String()]
+		if !ok {
+			c = cron.New(cron.WithParser(parser), cron.WithLocation(loc))
+			byLocation[loc.String()] = c
+			crons = append(crons, c)
+		}
 
-		select {
-		case <-ctx.Done():
-			return
+		job := job
+		status := &JobStatus{}
+		circuit := &circuitBreaker{}
+		var entryID cron.EntryID
+		tick := cron.FuncJob(func() {
+			// Increment before context check to avoid shutdown race.
+			wg.Add(1)
+			defer wg.Done()
+
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			runTick(ctx, job, status, circuit, metrics)
+			metrics.setNextRun(job.Schedule, job.Command, c.Entry(entryID).Next)
+		})
+
+		overlapLogger := slogCronLogger{schedule: job.Schedule, command: job.Command}
+		var wrapped cron.Job
+		switch overlapPolicy(job) {
+		case OverlapSkip:
+			wrapped = cron.NewChain(cron.SkipIfStillRunning(overlapLogger)).Then(tick)
+		case OverlapQueue:
+			wrapped = cron.NewChain(cron.DelayIfStillRunning(overlapLogger)).Then(tick)
 		default:
+			wrapped = tick
 		}
 
-		if err := execute(ctx, schedule, command, args); err != nil {
-			logger.Error("command execution error", "schedule", schedule, "command", command, "error", err)
+		id, err := c.AddJob(job.Schedule, wrapped)
+		if err != nil {
+			return nil, nil, fmt.Errorf("register job '%s': %w", job.Command, err)
 		}
-	})
+		entryID = id
+
+		scheduled = append(scheduled, scheduledJob{cron: c, id: id, job: job, status: status, circuit: circuit})
+		logger.Info("new cron scheduled", "schedule", job.Schedule, "command", job.Command, "timezone", loc.String())
+	}
 
-	return c, wg, nil
+	return crons, scheduled, nil
 }
 
-// stop gracefully shuts down the scheduler with a timeout.
-func stop(c *cron.Cron, wg *sync.WaitGroup) {
+// statusViews builds the JSON-serializable status of every scheduled job,
+// including its live next-fire time.
+func statusViews(scheduled []scheduledJob) []JobStatusView {
+	views := make([]JobStatusView, 0, len(scheduled))
+	for _, s := range scheduled {
+		lastStart, lastFinish, lastExitCode, lastError, successes, failures := s.status.snapshot()
+		circuitOpen, pausedUntil := s.circuit.status(time.Now())
+		views = append(views, JobStatusView{
+			Schedule:     s.job.Schedule,
+			Command:      s.job.Command,
+			Next:         s.cron.Entry(s.id).Next,
+			LastStart:    lastStart,
+			LastFinish:   lastFinish,
+			LastExitCode: lastExitCode,
+			LastError:    lastError,
+			Successes:    successes,
+			Failures:     failures,
+			CircuitOpen:  circuitOpen,
+			PausedUntil:  pausedUntil,
+		})
+	}
+	return views
+}
+
+// logNextRuns reports the next fire time for every scheduled job, so
+// operators can confirm a timezone resolved the way they expected, and
+// seeds the next-run metric before the job has ticked for the first time.
+// It must be called after every scheduler's Start method has run.
+func logNextRuns(scheduled []scheduledJob, metrics *Metrics) {
+	for _, s := range scheduled {
+		next := s.cron.Entry(s.id).Next
+		logger.Info("next scheduled run", "schedule", s.job.Schedule, "command", s.job.Command, "next", next)
+		metrics.setNextRun(s.job.Schedule, s.job.Command, next)
+	}
+}
+
+// stop gracefully shuts down every scheduler with a shared timeout.
+func stop(crons []*cron.Cron, wg *sync.WaitGroup) {
 	logger.Info("stopping scheduler")
-	c.Stop()
+	for _, c := range crons {
+		c.Stop()
+	}
 	logger.Info("waiting for running jobs to complete", "timeout", shutdownTimeout)
 
 	done := make(chan struct{})
@@ -117,6 +344,73 @@ func showVersion() {
 	fmt.Printf("built by: %s\n", builtBy)
 }
 
+// usage prints the command's invocation forms and flag defaults.
+func usage() {
+	fmt.Println("Usage: go-cron [flags] [schedule] [command] [args ...]")
+	fmt.Println("       go-cron -config path.yaml")
+	fmt.Println("       go-cron version")
+	flag.PrintDefaults()
+}
+
+// positionalJobFlags carries the per-job flags available to the legacy
+// single positional `[schedule] [command] [args ...]` invocation form.
+type positionalJobFlags struct {
+	tz                 string
+	onOverlap          string
+	retries            int
+	retryBackoff       time.Duration
+	retryMaxBackoff    time.Duration
+	pauseAfterFailures int
+	pauseCooldown      time.Duration
+	jobTimeout         time.Duration
+	jobKillGrace       time.Duration
+}
+
+// jobsFromArgs builds the job list for either invocation mode: a `-config`
+// file describing one or more jobs, or the legacy single positional
+// `[schedule] [command] [args ...]` form, with flags applied to that single
+// job.
+func jobsFromArgs(configPath string, flags positionalJobFlags, args []string) ([]Job, error) {
+	if configPath != "" {
+		cfg, err := loadConfig(configPath)
+		if err != nil {
+			return nil, err
+		}
+		return cfg.Jobs, nil
+	}
+
+	if flags.onOverlap != "" {
+		if err := validateOverlapPolicy(flags.onOverlap); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateRetries(flags.retries); err != nil {
+		return nil, err
+	}
+	if flags.pauseAfterFailures < 0 {
+		return nil, fmt.Errorf("-pause-after-failures must be >= 0, got %d", flags.pauseAfterFailures)
+	}
+
+	if len(args) < minPositionalArgs {
+		return nil, fmt.Errorf("not enough arguments")
+	}
+
+	return []Job{{
+		Schedule:           args[0],
+		Command:            args[1],
+		Args:               args[2:],
+		Timezone:           flags.tz,
+		OnOverlap:          flags.onOverlap,
+		Retries:            flags.retries,
+		RetryBackoff:       Duration(flags.retryBackoff),
+		RetryMaxBackoff:    Duration(flags.retryMaxBackoff),
+		PauseAfterFailures: flags.pauseAfterFailures,
+		PauseCooldown:      Duration(flags.pauseCooldown),
+		Timeout:            Duration(flags.jobTimeout),
+		KillGrace:          Duration(flags.jobKillGrace),
+	}}, nil
+}
+
 // main parses arguments and runs the scheduler with signal handling.
 func main() {
 	if len(os.Args) >= 2 && os.Args[1] == "version" {
@@ -124,26 +418,61 @@ func main() {
 		return
 	}
 
-	if len(os.Args) < minArgs {
-		fmt.Println("Usage: go-cron [schedule] [command] [args ...]")
-		fmt.Println("       go-cron version")
+	flag.Usage = usage
+	configPath := flag.String("config", "", "path to a YAML/JSON job config file")
+	tz := flag.String("tz", "", "timezone for the positional [schedule] [command] form")
+	onOverlap := flag.String("on-overlap", "", "overlap policy for the positional [schedule] [command] form: allow, skip or queue (default skip)")
+	retries := flag.Int("retries", 0, "number of retries after a failed run, before giving up on that tick")
+	retryBackoff := flag.Duration("retry-backoff", defaultRetryBackoff, "delay before the first retry; doubles on each subsequent attempt")
+	retryMaxBackoff := flag.Duration("retry-max-backoff", defaultRetryMaxBackoff, "cap on the exponential retry backoff")
+	pauseAfterFailures := flag.Int("pause-after-failures", 0, "open the circuit breaker after this many consecutive failed ticks (0 disables it)")
+	pauseCooldown := flag.Duration("pause-cooldown", defaultPauseCooldown, "how long the circuit breaker stays open before retrying")
+	jobTimeout := flag.Duration("job-timeout", 0, "maximum duration of a single run, 0 disables it (e.g. 15m)")
+	jobKillGrace := flag.Duration("job-kill-grace", defaultKillGrace, "time to wait after SIGTERM before escalating to SIGKILL")
+	httpAddr := flag.String("http-addr", "", "address to serve /healthz, /readyz and /status on, e.g. :8080")
+	flag.Parse()
+
+	jobs, err := jobsFromArgs(*configPath, positionalJobFlags{
+		tz:                 *tz,
+		onOverlap:          *onOverlap,
+		retries:            *retries,
+		retryBackoff:       *retryBackoff,
+		retryMaxBackoff:    *retryMaxBackoff,
+		pauseAfterFailures: *pauseAfterFailures,
+		pauseCooldown:      *pauseCooldown,
+		jobTimeout:         *jobTimeout,
+		jobKillGrace:       *jobKillGrace,
+	}, flag.Args())
+	if err != nil {
+		usage()
 		os.Exit(1)
 	}
 
-	schedule := os.Args[1]
-	command := os.Args[2]
-	args := os.Args[3:]
-
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	c, wg, err := create(ctx, schedule, command, args)
+	registry := prometheus.NewRegistry()
+	metrics := newMetrics(registry)
+
+	wg := &sync.WaitGroup{}
+	crons, scheduled, err := create(ctx, jobs, wg, metrics)
 	if err != nil {
 		logger.Error("failed to create scheduler", "error", err)
 		os.Exit(1)
 	}
 
-	c.Start()
+	var ready atomic.Bool
+	var httpServer *http.Server
+	if *httpAddr != "" {
+		httpServer = newHTTPServer(*httpAddr, scheduled, ready.Load, registry)
+		startHTTPServer(httpServer)
+	}
+
+	for _, c := range crons {
+		c.Start()
+	}
+	ready.Store(true)
+	logNextRuns(scheduled, metrics)
 
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
@@ -151,6 +480,11 @@ func main() {
 	logger.Info("received signal", "signal", sig)
 
 	cancel()
-	stop(c, wg)
+	if httpServer != nil {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		stopHTTPServer(shutdownCtx, httpServer)
+		shutdownCancel()
+	}
+	stop(crons, wg)
 	os.Exit(0)
 }
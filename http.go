@@ -0,0 +1,72 @@
+// Copyright 2025 Focela Authors.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0.
+// See the LICENSE file in the project root for full license information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// newHTTPServer builds the optional status/health server. /healthz always
+// reports the process as alive; /readyz reports ready once the schedulers
+// have been started; /status returns the per-job run history used by
+// dashboards and debugging; /metrics exposes Prometheus collectors.
+func newHTTPServer(addr string, scheduled []scheduledJob, ready func() bool, registry *prometheus.Registry) *http.Server {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	})
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if !ready() {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	})
+
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(statusViews(scheduled)); err != nil {
+			logger.Error("failed to encode status response", "error", err)
+		}
+	})
+
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	return &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+}
+
+// startHTTPServer starts the server in the background and logs any error
+// other than the expected one on shutdown.
+func startHTTPServer(srv *http.Server) {
+	logger.Info("starting http server", "addr", srv.Addr)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("http server error", "error", err)
+		}
+	}()
+}
+
+// stopHTTPServer shuts the server down gracefully, bounded by ctx.
+func stopHTTPServer(ctx context.Context, srv *http.Server) {
+	logger.Info("stopping http server")
+	if err := srv.Shutdown(ctx); err != nil {
+		logger.Warn("http server shutdown error", "error", err)
+	}
+}
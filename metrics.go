@@ -0,0 +1,78 @@
+// Copyright 2025 Focela Authors.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0.
+// See the LICENSE file in the project root for full license information.
+
+package main
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics holds the Prometheus collectors describing job runs, exposed on
+// /metrics so go-cron is a first-class target for alerting on missed runs
+// and duration regressions. Series are labeled by both schedule and command
+// so two jobs sharing a cron expression don't merge into one series.
+type Metrics struct {
+	runsTotal *prometheus.CounterVec
+	duration  *prometheus.HistogramVec
+	lastRun   *prometheus.GaugeVec
+	nextRun   *prometheus.GaugeVec
+	inFlight  *prometheus.GaugeVec
+}
+
+// newMetrics constructs the collectors and registers them on reg.
+func newMetrics(reg *prometheus.Registry) *Metrics {
+	m := &Metrics{
+		runsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gocron_job_runs_total",
+			Help: "Total number of job runs, by schedule, command and outcome.",
+		}, []string{"schedule", "command", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "gocron_job_duration_seconds",
+			Help: "Job run duration in seconds.",
+		}, []string{"schedule", "command"}),
+		lastRun: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gocron_job_last_run_timestamp_seconds",
+			Help: "Unix timestamp of the last completed run.",
+		}, []string{"schedule", "command"}),
+		nextRun: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gocron_job_next_run_timestamp_seconds",
+			Help: "Unix timestamp of the next scheduled run.",
+		}, []string{"schedule", "command"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gocron_job_in_flight",
+			Help: "Number of currently running instances of a job.",
+		}, []string{"schedule", "command"}),
+	}
+
+	reg.MustRegister(m.runsTotal, m.duration, m.lastRun, m.nextRun, m.inFlight)
+	return m
+}
+
+// observeRun records the outcome of one completed job run.
+func (m *Metrics) observeRun(schedule, command string, took time.Duration, err error) {
+	status := "success"
+	if err != nil {
+		status = "failure"
+	}
+	m.runsTotal.WithLabelValues(schedule, command, status).Inc()
+	m.duration.WithLabelValues(schedule, command).Observe(took.Seconds())
+	m.lastRun.WithLabelValues(schedule, command).Set(float64(time.Now().Unix()))
+}
+
+// setNextRun updates the next-run gauge from the scheduler's own Entry.Next.
+func (m *Metrics) setNextRun(schedule, command string, next time.Time) {
+	m.nextRun.WithLabelValues(schedule, command).Set(float64(next.Unix()))
+}
+
+func (m *Metrics) incInFlight(schedule, command string) {
+	m.inFlight.WithLabelValues(schedule, command).Inc()
+}
+func (m *Metrics) decInFlight(schedule, command string) {
+	m.inFlight.WithLabelValues(schedule, command).Dec()
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 Focela Authors.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0.
+// See the LICENSE file in the project root for full license information.
+
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRetryBackoff    = 30 * time.Second
+	defaultRetryMaxBackoff = 10 * time.Minute
+	defaultPauseCooldown   = 5 * time.Minute
+)
+
+// executeWithRetry runs a job, retrying on failure up to job.Retries times
+// with exponential backoff between attempts (capped at RetryMaxBackoff). It
+// returns the error from the final attempt, or nil on the first success.
+func executeWithRetry(ctx context.Context, job Job) error {
+	backoff := job.RetryBackoff.Duration()
+	if backoff <= 0 {
+		backoff = defaultRetryBackoff
+	}
+	maxBackoff := job.RetryMaxBackoff.Duration()
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	// A negative value (e.g. a stray -retries=-1) must not skip execution
+	// altogether; treat it the same as 0 retries.
+	retries := job.Retries
+	if retries < 0 {
+		retries = 0
+	}
+
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		err = execute(ctx, job)
+		if err == nil {
+			return nil
+		}
+		if attempt == retries {
+			break
+		}
+
+		sleep := backoff * time.Duration(int64(1)<<uint(attempt))
+		if sleep <= 0 || sleep > maxBackoff {
+			sleep = maxBackoff
+		}
+		logger.Warn("retrying after failure", "schedule", job.Schedule, "command", job.Command,
+			"attempt", attempt+1, "backoff", sleep, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(sleep):
+		}
+	}
+	return err
+}
+
+// circuitBreaker tracks a job's consecutive-failure streak and, once it
+// crosses a threshold, opens for a cool-down period during which the job is
+// skipped entirely rather than ticking (and failing) again.
+type circuitBreaker struct {
+	mu                  sync.Mutex
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+// open reports whether the circuit is currently open.
+func (cb *circuitBreaker) open(now time.Time) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.openUntil.After(now)
+}
+
+// status returns whether the circuit is currently open and, if so, until
+// when, for reporting on the /status endpoint.
+func (cb *circuitBreaker) status(now time.Time) (open bool, until time.Time) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.openUntil.After(now) {
+		return true, cb.openUntil
+	}
+	return false, time.Time{}
+}
+
+// recordSuccess resets the failure streak and closes the circuit.
+func (cb *circuitBreaker) recordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.consecutiveFailures = 0
+	cb.openUntil = time.Time{}
+}
+
+// recordFailure extends the failure streak, opening the circuit for
+// cooldown once it reaches threshold. It reports whether this call opened
+// the circuit.
+func (cb *circuitBreaker) recordFailure(threshold int, cooldown time.Duration) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.consecutiveFailures++
+	if threshold > 0 && cb.consecutiveFailures >= threshold {
+		cb.openUntil = time.Now().Add(cooldown)
+		return true
+	}
+	return false
+}
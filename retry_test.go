@@ -0,0 +1,123 @@
+// Copyright 2025 Focela Authors.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0.
+// See the LICENSE file in the project root for full license information.
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestExecuteWithRetryNegativeRetriesStillRuns(t *testing.T) {
+	job := Job{Schedule: "@every 1s", Command: "false", Retries: -1}
+
+	err := executeWithRetry(context.Background(), job)
+	if err == nil {
+		t.Fatal("expected the command to run (and fail), got nil error")
+	}
+}
+
+func TestExecuteWithRetrySucceedsWithoutRetrying(t *testing.T) {
+	job := Job{Schedule: "@every 1s", Command: "true", Retries: 3}
+
+	if err := executeWithRetry(context.Background(), job); err != nil {
+		t.Fatalf("executeWithRetry() = %v, want nil", err)
+	}
+}
+
+func TestExecuteWithRetryExhaustsAttempts(t *testing.T) {
+	job := Job{
+		Schedule:     "@every 1s",
+		Command:      "false",
+		Retries:      2,
+		RetryBackoff: Duration(time.Millisecond),
+	}
+
+	err := executeWithRetry(context.Background(), job)
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries, got nil")
+	}
+}
+
+func TestExecuteWithRetryStopsOnContextCancel(t *testing.T) {
+	job := Job{
+		Schedule:     "@every 1s",
+		Command:      "false",
+		Retries:      5,
+		RetryBackoff: Duration(time.Hour),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	if err := executeWithRetry(ctx, job); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("executeWithRetry did not stop promptly on cancellation, took %v", elapsed)
+	}
+}
+
+func TestCircuitBreakerOpensAtThreshold(t *testing.T) {
+	cb := &circuitBreaker{}
+	now := time.Now()
+
+	if cb.recordFailure(2, time.Minute) {
+		t.Fatal("circuit opened after first failure, want it to stay closed")
+	}
+	if open, _ := cb.status(now); open {
+		t.Fatal("circuit reports open before reaching threshold")
+	}
+
+	if !cb.recordFailure(2, time.Minute) {
+		t.Fatal("circuit did not open at threshold")
+	}
+	open, until := cb.status(now)
+	if !open {
+		t.Fatal("circuit reports closed after reaching threshold")
+	}
+	if !until.After(now) {
+		t.Fatalf("openUntil = %v, want after %v", until, now)
+	}
+}
+
+func TestCircuitBreakerRecordSuccessCloses(t *testing.T) {
+	cb := &circuitBreaker{}
+	cb.recordFailure(1, time.Minute)
+	if !cb.open(time.Now()) {
+		t.Fatal("circuit should be open after reaching threshold of 1")
+	}
+
+	cb.recordSuccess()
+	if cb.open(time.Now()) {
+		t.Fatal("circuit should be closed after recordSuccess")
+	}
+	if open, until := cb.status(time.Now()); open || !until.IsZero() {
+		t.Fatalf("status() = (%v, %v), want (false, zero)", open, until)
+	}
+}
+
+func TestCircuitBreakerStatusClosesAfterCooldown(t *testing.T) {
+	cb := &circuitBreaker{}
+	cb.recordFailure(1, time.Millisecond)
+
+	time.Sleep(5 * time.Millisecond)
+	if open, until := cb.status(time.Now()); open || !until.IsZero() {
+		t.Fatalf("status() after cooldown = (%v, %v), want (false, zero)", open, until)
+	}
+}
+
+func TestCircuitBreakerThresholdDisabled(t *testing.T) {
+	cb := &circuitBreaker{}
+	for i := 0; i < 10; i++ {
+		if cb.recordFailure(0, time.Minute) {
+			t.Fatal("threshold 0 must never open the circuit")
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 Focela Authors.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0.
+// See the LICENSE file in the project root for full license information.
+
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// JobStatus tracks the run history of a single scheduled job. It is updated
+// from the job's tick function and read concurrently by the HTTP status
+// endpoint, so all access goes through the mutex.
+type JobStatus struct {
+	mu sync.Mutex
+
+	lastStart    time.Time
+	lastFinish   time.Time
+	lastExitCode int
+	lastError    string
+	successes    uint64
+	failures     uint64
+}
+
+// recordStart marks the beginning of a run.
+func (s *JobStatus) recordStart(at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastStart = at
+}
+
+// recordFinish marks the end of a run, along with its exit code and error
+// (empty if the run succeeded).
+func (s *JobStatus) recordFinish(at time.Time, exitCode int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastFinish = at
+	s.lastExitCode = exitCode
+	if err != nil {
+		s.lastError = err.Error()
+		s.failures++
+	} else {
+		s.lastError = ""
+		s.successes++
+	}
+}
+
+// JobStatusView is the JSON-serializable snapshot of a JobStatus returned by
+// the `/status` endpoint.
+type JobStatusView struct {
+	Schedule     string    `json:"schedule"`
+	Command      string    `json:"command"`
+	Next         time.Time `json:"next"`
+	LastStart    time.Time `json:"last_start,omitempty"`
+	LastFinish   time.Time `json:"last_finish,omitempty"`
+	LastExitCode int       `json:"last_exit_code"`
+	LastError    string    `json:"last_error,omitempty"`
+	Successes    uint64    `json:"successes"`
+	Failures     uint64    `json:"failures"`
+	CircuitOpen  bool      `json:"circuit_open"`
+	PausedUntil  time.Time `json:"paused_until,omitempty"`
+}
+
+// snapshot returns a point-in-time copy of the status, safe to serialize.
+func (s *JobStatus) snapshot() (lastStart, lastFinish time.Time, lastExitCode int, lastError string, successes, failures uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastStart, s.lastFinish, s.lastExitCode, s.lastError, s.successes, s.failures
+}